@@ -0,0 +1,203 @@
+package b2ccustompolicy
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// trustFrameworkPolicyXML models only the attributes needed to validate and
+// identify an Identity Experience Framework custom policy document.
+type trustFrameworkPolicyXML struct {
+	XMLName  xml.Name `xml:"TrustFrameworkPolicy"`
+	PolicyId string   `xml:"PolicyId,attr"`
+}
+
+func trustFrameworkPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: trustFrameworkPolicyResourceCreate,
+		ReadContext:   trustFrameworkPolicyResourceRead,
+		UpdateContext: trustFrameworkPolicyResourceUpdate,
+		DeleteContext: trustFrameworkPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID (%q) is not valid", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Description: "The `PolicyId` declared in the policy XML, used as this policy's unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content": {
+				Description:      "The raw TrustFrameworkPolicy XML content, or the path to a local XML file",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+				DiffSuppressFunc: tf.WhitespaceInsensitiveContentDiffSuppress,
+			},
+		},
+	}
+}
+
+func trustFrameworkPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CCustomPolicy.TrustFrameworkPolicyClient
+
+	rawValue := d.Get("content").(string)
+	content, err := tf.ReadFileOrString(rawValue)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Reading policy content")
+	}
+
+	policy, err := parseTrustFrameworkPolicy(rawValue, content)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Validating policy content")
+	}
+
+	if _, _, err := client.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		ContentType: "application/xml",
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s/$value", policy.PolicyId),
+		},
+		Body: []byte(content),
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Creating trust framework policy %q", policy.PolicyId)
+	}
+
+	d.SetId(policy.PolicyId)
+	return trustFrameworkPolicyResourceRead(ctx, d, meta)
+}
+
+func trustFrameworkPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CCustomPolicy.TrustFrameworkPolicyClient
+
+	policyId := d.Id()
+
+	rawValue := d.Get("content").(string)
+	content, err := tf.ReadFileOrString(rawValue)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Reading policy content")
+	}
+
+	policy, err := parseTrustFrameworkPolicy(rawValue, content)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Validating policy content")
+	}
+
+	if policy.PolicyId != policyId {
+		return tf.ErrorDiagF(fmt.Errorf("PolicyId in content (%q) does not match the existing policy (%q); PolicyId is immutable", policy.PolicyId, policyId), "Updating trust framework policy")
+	}
+
+	if _, _, err := client.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		ContentType: "application/xml",
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s/$value", policyId),
+		},
+		Body: []byte(content),
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Updating trust framework policy %q", policyId)
+	}
+
+	return trustFrameworkPolicyResourceRead(ctx, d, meta)
+}
+
+func trustFrameworkPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CCustomPolicy.TrustFrameworkPolicyClient
+
+	policyId := d.Id()
+
+	resp, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s/$value", policyId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Trust framework policy %q was not found - removing from state!", policyId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving trust framework policy %q", policyId)
+	}
+
+	tf.Set(d, "policy_id", policyId)
+	tf.Set(d, "content", resp.String())
+	return nil
+}
+
+func trustFrameworkPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CCustomPolicy.TrustFrameworkPolicyClient
+
+	policyId := d.Id()
+
+	if _, status, err := client.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s", policyId),
+		},
+	}); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Trust framework policy %q was not found - removing from state!", policyId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Deleting trust framework policy %q, got status %d", policyId, status)
+	}
+
+	return nil
+}
+
+// parseTrustFrameworkPolicy validates that the supplied content is a well-formed
+// TrustFrameworkPolicy document and, when rawValue is a path to a local file rather than
+// inline XML, that PolicyId matches the filename (e.g. a file named `B2C_1A_signup.xml`
+// must declare `PolicyId="B2C_1A_signup"`). Inline content has no filename to check against,
+// so that check is skipped in that case.
+func parseTrustFrameworkPolicy(rawValue, content string) (*trustFrameworkPolicyXML, error) {
+	var policy trustFrameworkPolicyXML
+	if err := xml.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("content is not valid XML: %+v", err)
+	}
+
+	if policy.XMLName.Local != "TrustFrameworkPolicy" {
+		return nil, fmt.Errorf("root element must be `TrustFrameworkPolicy`, got %q", policy.XMLName.Local)
+	}
+
+	if policy.PolicyId == "" {
+		return nil, fmt.Errorf("PolicyId attribute is required on the root element")
+	}
+
+	if info, err := os.Stat(rawValue); err == nil && !info.IsDir() {
+		filenameStem := strings.TrimSuffix(filepath.Base(rawValue), filepath.Ext(rawValue))
+		if filenameStem != policy.PolicyId {
+			return nil, fmt.Errorf("PolicyId %q does not match the filename convention; expected a file named %q, got %q", policy.PolicyId, policy.PolicyId+filepath.Ext(rawValue), filepath.Base(rawValue))
+		}
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,49 @@
+package b2ccustompolicy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const validPolicyXML = `<TrustFrameworkPolicy PolicyId="B2C_1A_signup" xmlns="http://schemas.microsoft.com/online/cpim/schemas/2013/06"></TrustFrameworkPolicy>`
+
+func TestParseTrustFrameworkPolicy_InlineContent(t *testing.T) {
+	policy, err := parseTrustFrameworkPolicy(validPolicyXML, validPolicyXML)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if policy.PolicyId != "B2C_1A_signup" {
+		t.Errorf("expected PolicyId %q, got %q", "B2C_1A_signup", policy.PolicyId)
+	}
+}
+
+func TestParseTrustFrameworkPolicy_WrongRootElement(t *testing.T) {
+	if _, err := parseTrustFrameworkPolicy("<NotAPolicy/>", "<NotAPolicy/>"); err == nil {
+		t.Fatal("expected an error for a non-TrustFrameworkPolicy root element")
+	}
+}
+
+func TestParseTrustFrameworkPolicy_FilenameMatchesPolicyId(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "B2C_1A_signup.xml")
+	if err := os.WriteFile(path, []byte(validPolicyXML), 0o600); err != nil {
+		t.Fatalf("writing fixture file: %+v", err)
+	}
+
+	if _, err := parseTrustFrameworkPolicy(path, validPolicyXML); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestParseTrustFrameworkPolicy_FilenameMismatchesPolicyId(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "B2C_1A_wrong_name.xml")
+	if err := os.WriteFile(path, []byte(validPolicyXML), 0o600); err != nil {
+		t.Fatalf("writing fixture file: %+v", err)
+	}
+
+	if _, err := parseTrustFrameworkPolicy(path, validPolicyXML); err == nil {
+		t.Fatal("expected an error when the filename does not match PolicyId")
+	}
+}
@@ -0,0 +1,19 @@
+package b2cuserflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// unmarshalGraphResponse decodes a raw Graph API JSON response body, as returned by the
+// hamilton BaseClient's untyped Get/Post/Patch/Delete methods, into the given target.
+func unmarshalGraphResponse(body *bytes.Buffer, v interface{}) error {
+	if body == nil {
+		return fmt.Errorf("response body was nil")
+	}
+	if err := json.Unmarshal(body.Bytes(), v); err != nil {
+		return fmt.Errorf("unmarshalling response: %+v", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package b2cuserflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func b2cUserflowApiConnectorResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: b2cUserflowApiConnectorResourceCreate,
+		ReadContext:   b2cUserflowApiConnectorResourceRead,
+		UpdateContext: b2cUserflowApiConnectorResourceUpdate,
+		DeleteContext: b2cUserflowApiConnectorResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID (%q) is not valid", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description: "The object ID of the API connector",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:      "The display name of the API connector",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"target_url": {
+				Description:      "The URL of the HTTP endpoint to call",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"username": {
+				Description:      "The username used for basic authentication to the API endpoint",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"password": {
+				Description:      "The password used for basic authentication to the API endpoint",
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func b2cUserflowApiConnectorResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.ApiConnectorClient
+
+	connector := msgraph.IdentityApiConnector{
+		DisplayName: utils.String(d.Get("name").(string)),
+		TargetUrl:   utils.String(d.Get("target_url").(string)),
+		AuthenticationConfiguration: &msgraph.ApiConnectorBasicAuthentication{
+			Username: utils.String(d.Get("username").(string)),
+			Password: utils.String(d.Get("password").(string)),
+		},
+	}
+
+	connectorResp, _, err := client.Create(ctx, connector)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating API connector %q", d.Get("name").(string))
+	}
+
+	if connectorResp.ID == nil || *connectorResp.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("API returned nil object ID"), "Bad API Response")
+	}
+
+	d.SetId(*connectorResp.ID)
+	return b2cUserflowApiConnectorResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowApiConnectorResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.ApiConnectorClient
+
+	objectId := d.Id()
+	connector := msgraph.IdentityApiConnector{
+		ID:          &objectId,
+		DisplayName: utils.String(d.Get("name").(string)),
+		TargetUrl:   utils.String(d.Get("target_url").(string)),
+		AuthenticationConfiguration: &msgraph.ApiConnectorBasicAuthentication{
+			Username: utils.String(d.Get("username").(string)),
+			Password: utils.String(d.Get("password").(string)),
+		},
+	}
+
+	if _, err := client.Update(ctx, connector); err != nil {
+		return tf.ErrorDiagF(err, "Could not update API connector with ID: %q", d.Id())
+	}
+	return b2cUserflowApiConnectorResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowApiConnectorResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.ApiConnectorClient
+
+	objectId := d.Id()
+
+	connector, status, err := client.Get(ctx, objectId, odata.Query{})
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] API connector with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving API connector with object ID: %q", objectId)
+	}
+
+	tf.Set(d, "object_id", *connector.ID)
+	tf.Set(d, "name", *connector.DisplayName)
+	tf.Set(d, "target_url", *connector.TargetUrl)
+	if auth, ok := connector.AuthenticationConfiguration.(*msgraph.ApiConnectorBasicAuthentication); ok && auth != nil {
+		tf.Set(d, "username", *auth.Username)
+	}
+	// The password is write-only and is never returned by the API, so it is preserved from config.
+	return nil
+}
+
+func b2cUserflowApiConnectorResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.ApiConnectorClient
+
+	objectId := d.Id()
+
+	status, err := client.Delete(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] API connector with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Deleting API connector with object ID %q, got status %d", objectId, status)
+	}
+
+	return nil
+}
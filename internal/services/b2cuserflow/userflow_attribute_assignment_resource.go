@@ -0,0 +1,324 @@
+package b2cuserflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/locks"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// userAttributeAssignmentLockKey scopes the lock guarding the read-modify-write cycle below
+// to a single user flow, matching the pattern used elsewhere in this provider for resources
+// that manage their own slice of a larger, shared Graph collection (e.g. group membership).
+func userAttributeAssignmentLockKey(userFlowId string) string {
+	return fmt.Sprintf("b2cUserflowAttributeAssignment-%s", userFlowId)
+}
+
+type userAttributeRef struct {
+	ID string `json:"id"`
+}
+
+type userAttributeAssignment struct {
+	UserAttribute        userAttributeRef `json:"userAttribute"`
+	DisplayName          string           `json:"displayName"`
+	IsOptional           bool             `json:"isOptional"`
+	RequiresVerification bool             `json:"requiresVerification"`
+	UserInputType        string           `json:"userInputType"`
+}
+
+func b2cUserflowAttributeAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: b2cUserflowAttributeAssignmentResourceCreate,
+		ReadContext:   b2cUserflowAttributeAssignmentResourceRead,
+		UpdateContext: b2cUserflowAttributeAssignmentResourceUpdate,
+		DeleteContext: b2cUserflowAttributeAssignmentResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, _, err := parseUserFlowAttributeAssignmentId(id); err != nil {
+				return err
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_flow_id": {
+				Description:      "The ID of the B2C user flow",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"user_attribute_id": {
+				Description:      "The ID of the user attribute to assign to the user flow",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"display_name": {
+				Description:      "The display name override for the attribute on this user flow",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"is_optional": {
+				Description: "Whether the end user can skip filling in this attribute",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"requires_verification": {
+				Description: "Whether the attribute value must be verified before the user flow can proceed",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"user_input_type": {
+				Description: "The way in which the attribute is presented to the end user",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"textBox",
+					"radioSingleSelect",
+					"dropdownSingleSelect",
+					"checkboxMultiSelect",
+					"dateTimeDropdown",
+				}, false),
+			},
+			"order": {
+				Description: "The position of this attribute within the user flow's ordered attribute collection",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func b2cUserflowAttributeAssignmentResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId := d.Get("user_flow_id").(string)
+	attributeId := d.Get("user_attribute_id").(string)
+
+	locks.ByName(userAttributeAssignmentLockKey(userFlowId))
+	defer locks.UnlockByName(userAttributeAssignmentLockKey(userFlowId))
+
+	assignments, status, err := listUserAttributeAssignments(ctx, client, userFlowId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing user attribute assignments for user flow %q, got status %d", userFlowId, status)
+	}
+
+	for _, a := range assignments {
+		if a.UserAttribute.ID == attributeId {
+			return tf.ErrorDiagF(fmt.Errorf("user attribute %q is already assigned to user flow %q", attributeId, userFlowId), "Creating user attribute assignment")
+		}
+	}
+
+	assignment := userAttributeAssignment{
+		UserAttribute:        userAttributeRef{ID: attributeId},
+		DisplayName:          d.Get("display_name").(string),
+		IsOptional:           d.Get("is_optional").(bool),
+		RequiresVerification: d.Get("requires_verification").(bool),
+		UserInputType:        d.Get("user_input_type").(string),
+	}
+
+	order := d.Get("order").(int)
+	assignments = insertAssignmentAtOrder(assignments, assignment, order)
+
+	if _, status, err := putUserAttributeAssignments(ctx, client, userFlowId, assignments); err != nil {
+		return tf.ErrorDiagF(err, "Assigning user attribute %q to user flow %q, got status %d", attributeId, userFlowId, status)
+	}
+
+	d.SetId(fmt.Sprintf("%s/assignments/%s", userFlowId, attributeId))
+	return b2cUserflowAttributeAssignmentResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowAttributeAssignmentResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, attributeId, err := parseUserFlowAttributeAssignmentId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user attribute assignment ID %q", d.Id())
+	}
+
+	locks.ByName(userAttributeAssignmentLockKey(userFlowId))
+	defer locks.UnlockByName(userAttributeAssignmentLockKey(userFlowId))
+
+	assignments, status, err := listUserAttributeAssignments(ctx, client, userFlowId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing user attribute assignments for user flow %q, got status %d", userFlowId, status)
+	}
+
+	assignments = removeAssignment(assignments, attributeId)
+
+	assignment := userAttributeAssignment{
+		UserAttribute:        userAttributeRef{ID: attributeId},
+		DisplayName:          d.Get("display_name").(string),
+		IsOptional:           d.Get("is_optional").(bool),
+		RequiresVerification: d.Get("requires_verification").(bool),
+		UserInputType:        d.Get("user_input_type").(string),
+	}
+
+	order := d.Get("order").(int)
+	assignments = insertAssignmentAtOrder(assignments, assignment, order)
+
+	if _, status, err := putUserAttributeAssignments(ctx, client, userFlowId, assignments); err != nil {
+		return tf.ErrorDiagF(err, "Updating user attribute assignment %q on user flow %q, got status %d", attributeId, userFlowId, status)
+	}
+
+	return b2cUserflowAttributeAssignmentResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowAttributeAssignmentResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, attributeId, err := parseUserFlowAttributeAssignmentId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user attribute assignment ID %q", d.Id())
+	}
+
+	assignments, status, err := listUserAttributeAssignments(ctx, client, userFlowId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] User flow %q was not found - removing attribute assignment from state!", userFlowId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing user attribute assignments for user flow %q", userFlowId)
+	}
+
+	for i, a := range assignments {
+		if a.UserAttribute.ID == attributeId {
+			tf.Set(d, "user_flow_id", userFlowId)
+			tf.Set(d, "user_attribute_id", a.UserAttribute.ID)
+			tf.Set(d, "display_name", a.DisplayName)
+			tf.Set(d, "is_optional", a.IsOptional)
+			tf.Set(d, "requires_verification", a.RequiresVerification)
+			tf.Set(d, "user_input_type", a.UserInputType)
+			tf.Set(d, "order", i)
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] User attribute %q is no longer assigned to user flow %q - removing from state!", attributeId, userFlowId)
+	d.SetId("")
+	return nil
+}
+
+func b2cUserflowAttributeAssignmentResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, attributeId, err := parseUserFlowAttributeAssignmentId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user attribute assignment ID %q", d.Id())
+	}
+
+	locks.ByName(userAttributeAssignmentLockKey(userFlowId))
+	defer locks.UnlockByName(userAttributeAssignmentLockKey(userFlowId))
+
+	assignments, status, err := listUserAttributeAssignments(ctx, client, userFlowId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing user attribute assignments for user flow %q", userFlowId)
+	}
+
+	assignments = removeAssignment(assignments, attributeId)
+
+	if _, status, err := putUserAttributeAssignments(ctx, client, userFlowId, assignments); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Removing user attribute assignment %q from user flow %q, got status %d", attributeId, userFlowId, status)
+	}
+
+	return nil
+}
+
+func parseUserFlowAttributeAssignmentId(id string) (userFlowId, attributeId string, err error) {
+	parts := strings.Split(id, "/assignments/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in the format {userFlowId}/assignments/{attributeId}, got %q", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func listUserAttributeAssignments(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId string) ([]userAttributeAssignment, int, error) {
+	resp, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments", userFlowId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		return nil, status, err
+	}
+
+	var result struct {
+		Value []userAttributeAssignment `json:"value"`
+	}
+	if err := unmarshalGraphResponse(resp, &result); err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
+
+func putUserAttributeAssignments(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId string, assignments []userAttributeAssignment) (*bool, int, error) {
+	body, err := json.Marshal(struct {
+		Value []userAttributeAssignment `json:"value"`
+	}{Value: assignments})
+	if err != nil {
+		return nil, 0, fmt.Errorf("marshalling user attribute assignments: %+v", err)
+	}
+
+	_, status, err := client.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		ContentType: "application/json",
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments", userFlowId),
+		},
+		Body: body,
+	})
+	return nil, status, err
+}
+
+func insertAssignmentAtOrder(assignments []userAttributeAssignment, assignment userAttributeAssignment, order int) []userAttributeAssignment {
+	if order < 0 {
+		order = 0
+	}
+	if order > len(assignments) {
+		order = len(assignments)
+	}
+	result := make([]userAttributeAssignment, 0, len(assignments)+1)
+	result = append(result, assignments[:order]...)
+	result = append(result, assignment)
+	result = append(result, assignments[order:]...)
+	return result
+}
+
+func removeAssignment(assignments []userAttributeAssignment, attributeId string) []userAttributeAssignment {
+	result := make([]userAttributeAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		if a.UserAttribute.ID != attributeId {
+			result = append(result, a)
+		}
+	}
+	return result
+}
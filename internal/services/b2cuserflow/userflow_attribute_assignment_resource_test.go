@@ -0,0 +1,36 @@
+package b2cuserflow
+
+import "testing"
+
+func TestInsertAssignmentAtOrder(t *testing.T) {
+	existing := []userAttributeAssignment{
+		{UserAttribute: userAttributeRef{ID: "a"}},
+		{UserAttribute: userAttributeRef{ID: "b"}},
+	}
+	inserted := userAttributeAssignment{UserAttribute: userAttributeRef{ID: "c"}}
+
+	got := insertAssignmentAtOrder(existing, inserted, 1)
+
+	want := []string{"a", "c", "b"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d assignments, got %d", len(want), len(got))
+	}
+	for i, id := range want {
+		if got[i].UserAttribute.ID != id {
+			t.Errorf("position %d: expected UserAttribute.ID %q, got %q", i, id, got[i].UserAttribute.ID)
+		}
+	}
+}
+
+func TestRemoveAssignment(t *testing.T) {
+	existing := []userAttributeAssignment{
+		{UserAttribute: userAttributeRef{ID: "a"}},
+		{UserAttribute: userAttributeRef{ID: "b"}},
+	}
+
+	got := removeAssignment(existing, "a")
+
+	if len(got) != 1 || got[0].UserAttribute.ID != "b" {
+		t.Fatalf("expected only %q to remain, got %+v", "b", got)
+	}
+}
@@ -0,0 +1,164 @@
+package b2cuserflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+type userFlowLanguage struct {
+	ID                 string `json:"id"`
+	IsEnabled          bool   `json:"isEnabled"`
+	DefaultPageContent string `json:"defaultPageContent"`
+}
+
+func b2cUserflowDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: b2cUserflowDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description:      "The object ID of the userflow",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+				ExactlyOneOf:     []string{"object_id", "name"},
+			},
+			"name": {
+				Description:      "The name of the user flow",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+				ExactlyOneOf:     []string{"object_id", "name"},
+			},
+			"user_flow_type": {
+				Description: "The type of user flow",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_flow_type_version": {
+				Description: "The version of the user flow",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"default_language_tag": {
+				Description: "Indicates the default language of the b2cIdentityUserFlow that is used when no ui_locale tag is specified in the request",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_language_customization_enabled": {
+				Description: "Whether language customization is enabled within the B2C user flow",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"languages": {
+				Description: "The languages configured for this user flow",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"language_tag": {
+							Description: "The RFC 5646 language tag",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_enabled": {
+							Description: "Whether this language is enabled on the user flow",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"default_page_content_override": {
+							Description: "The URL of the default page content override for this language, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func b2cUserflowDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	var objectId string
+	if v, ok := d.GetOk("object_id"); ok {
+		objectId = v.(string)
+	} else {
+		name := d.Get("name").(string)
+		objectId = fmt.Sprintf("B2C_1_%s", name)
+	}
+
+	userflow, status, err := client.Get(ctx, objectId, odata.Query{})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving userflow with object ID: %q, got status %d", objectId, status)
+	}
+
+	d.SetId(*userflow.ID)
+	tf.Set(d, "object_id", *userflow.ID)
+	tf.Set(d, "name", userFlowNameFromObjectId(*userflow.ID))
+	tf.Set(d, "user_flow_type", *userflow.UserFlowType)
+	tf.Set(d, "user_flow_type_version", *userflow.UserFlowTypeVersion)
+	tf.Set(d, "default_language_tag", *userflow.DefaultLanguageTag)
+	tf.Set(d, "is_language_customization_enabled", *userflow.IsLanguageCustomizationEnabled)
+
+	languages, status, err := listUserFlowLanguages(ctx, client, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing languages for userflow with object ID: %q, got status %d", objectId, status)
+	}
+
+	languagesModel := make([]map[string]interface{}, 0, len(languages))
+	for _, l := range languages {
+		languagesModel = append(languagesModel, map[string]interface{}{
+			"language_tag":                  l.ID,
+			"is_enabled":                    l.IsEnabled,
+			"default_page_content_override": l.DefaultPageContent,
+		})
+	}
+	tf.Set(d, "languages", languagesModel)
+
+	return nil
+}
+
+// userFlowNameFromObjectId derives the `name` attribute from a user flow's object ID, which is
+// always the `B2C_1_` prefix the Graph API prepends to the name supplied when the flow was created.
+func userFlowNameFromObjectId(objectId string) string {
+	return strings.TrimPrefix(objectId, "B2C_1_")
+}
+
+func listUserFlowLanguages(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId string) ([]userFlowLanguage, int, error) {
+	resp, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages", userFlowId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		return nil, status, err
+	}
+
+	var result struct {
+		Value []userFlowLanguage `json:"value"`
+	}
+	if err := unmarshalGraphResponse(resp, &result); err != nil {
+		return nil, status, err
+	}
+	return result.Value, status, nil
+}
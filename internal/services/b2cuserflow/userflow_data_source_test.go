@@ -0,0 +1,15 @@
+package b2cuserflow
+
+import "testing"
+
+func TestUserFlowNameFromObjectId(t *testing.T) {
+	cases := map[string]string{
+		"B2C_1_signupsignin": "signupsignin",
+		"B2C_1_my-flow":      "my-flow",
+	}
+	for objectId, want := range cases {
+		if got := userFlowNameFromObjectId(objectId); got != want {
+			t.Errorf("userFlowNameFromObjectId(%q): expected %q, got %q", objectId, want, got)
+		}
+	}
+}
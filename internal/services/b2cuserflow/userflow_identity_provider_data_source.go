@@ -0,0 +1,77 @@
+package b2cuserflow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func b2cUserflowIdentityProviderDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: b2cUserflowIdentityProviderDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_flow_id": {
+				Description:      "The ID of the B2C user flow",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"identity_provider_ids": {
+				Description: "The IDs of the identity providers currently attached to the user flow",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func b2cUserflowIdentityProviderDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId := d.Get("user_flow_id").(string)
+
+	refs, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders", userFlowId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing identity providers for user flow %q, got status %d", userFlowId, status)
+	}
+
+	var result struct {
+		Value []struct {
+			ID string `json:"id"`
+		} `json:"value"`
+	}
+	if err := unmarshalGraphResponse(refs, &result); err != nil {
+		return tf.ErrorDiagF(err, "Parsing identity providers response for user flow %q", userFlowId)
+	}
+
+	identityProviderIds := make([]string, 0, len(result.Value))
+	for _, v := range result.Value {
+		identityProviderIds = append(identityProviderIds, v.ID)
+	}
+
+	d.SetId(userFlowId)
+	tf.Set(d, "identity_provider_ids", identityProviderIds)
+	return nil
+}
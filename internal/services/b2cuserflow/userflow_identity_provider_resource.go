@@ -0,0 +1,175 @@
+package b2cuserflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func b2cUserflowIdentityProviderResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: b2cUserflowIdentityProviderResourceCreate,
+		ReadContext:   b2cUserflowIdentityProviderResourceRead,
+		DeleteContext: b2cUserflowIdentityProviderResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if !strings.Contains(id, "/") {
+				return fmt.Errorf("specified ID (%q) is not valid: expected format {userFlowId}/{identityProviderId}", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_flow_id": {
+				Description:      "The ID of the B2C user flow to attach the identity provider to",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"identity_provider_id": {
+				Description:      "The ID of the identity provider (e.g. a built-in provider such as `Facebook-OAuth` or the object ID of a custom OIDC identity provider)",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func b2cUserflowIdentityProviderResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId := d.Get("user_flow_id").(string)
+	identityProviderId := d.Get("identity_provider_id").(string)
+
+	if _, status, err := client.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		ContentType: "application/json",
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/$ref", userFlowId),
+		},
+		Body: []byte(fmt.Sprintf(`{"@odata.id":%q}`, identityProviderODataId(client, identityProviderId))),
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Attaching identity provider %q to user flow %q, got status %d", identityProviderId, userFlowId, status)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userFlowId, identityProviderId))
+	return b2cUserflowIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowIdentityProviderResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, identityProviderId, err := parseUserFlowIdentityProviderId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing identity provider attachment ID %q", d.Id())
+	}
+
+	attached, status, err := identityProviderIsAttached(ctx, client, userFlowId, identityProviderId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] User flow %q was not found - removing identity provider attachment from state!", userFlowId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing identity providers for user flow %q", userFlowId)
+	}
+
+	if !attached {
+		log.Printf("[DEBUG] Identity provider %q is no longer attached to user flow %q - removing from state!", identityProviderId, userFlowId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "user_flow_id", userFlowId)
+	tf.Set(d, "identity_provider_id", identityProviderId)
+	return nil
+}
+
+func b2cUserflowIdentityProviderResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, identityProviderId, err := parseUserFlowIdentityProviderId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing identity provider attachment ID %q", d.Id())
+	}
+
+	if _, status, err := client.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/%s/$ref", userFlowId, identityProviderId),
+		},
+	}); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Identity provider %q was not found on user flow %q - removing from state!", identityProviderId, userFlowId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Detaching identity provider %q from user flow %q, got status %d", identityProviderId, userFlowId, status)
+	}
+
+	return nil
+}
+
+// identityProviderODataId builds the fully-qualified @odata.id for an identity provider from
+// the client's own configured endpoint and API version, so attach/detach works against
+// whichever national cloud (public, US Gov, China, Germany) the client is configured for.
+func identityProviderODataId(client *msgraph.B2CUserFlowClient, identityProviderId string) string {
+	return fmt.Sprintf("%s/%s/identity/identityProviders/%s", client.BaseClient.Endpoint, client.BaseClient.ApiVersion, identityProviderId)
+}
+
+// parseUserFlowIdentityProviderId splits a resource ID of the form {userFlowId}/{identityProviderId}.
+func parseUserFlowIdentityProviderId(id string) (userFlowId, identityProviderId string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected ID in the format {userFlowId}/{identityProviderId}, got %q", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// identityProviderIsAttached reconciles drift by listing the current identityProviders refs on the flow.
+func identityProviderIsAttached(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId, identityProviderId string) (bool, int, error) {
+	refs, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders", userFlowId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		return false, status, err
+	}
+
+	var result struct {
+		Value []struct {
+			ID string `json:"id"`
+		} `json:"value"`
+	}
+	if err := unmarshalGraphResponse(refs, &result); err != nil {
+		return false, status, err
+	}
+
+	for _, v := range result.Value {
+		if v.ID == identityProviderId {
+			return true, status, nil
+		}
+	}
+	return false, status, nil
+}
@@ -0,0 +1,36 @@
+package b2cuserflow
+
+import (
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestIdentityProviderODataId(t *testing.T) {
+	client := &msgraph.B2CUserFlowClient{
+		BaseClient: msgraph.Client{
+			Endpoint:   "https://graph.microsoft.us",
+			ApiVersion: "v1.0",
+		},
+	}
+
+	got := identityProviderODataId(client, "Facebook-OAuth")
+	want := "https://graph.microsoft.us/v1.0/identity/identityProviders/Facebook-OAuth"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestParseUserFlowIdentityProviderId(t *testing.T) {
+	userFlowId, identityProviderId, err := parseUserFlowIdentityProviderId("B2C_1_signup/Facebook-OAuth")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if userFlowId != "B2C_1_signup" || identityProviderId != "Facebook-OAuth" {
+		t.Errorf("expected (%q, %q), got (%q, %q)", "B2C_1_signup", "Facebook-OAuth", userFlowId, identityProviderId)
+	}
+
+	if _, _, err := parseUserFlowIdentityProviderId("not-a-valid-id"); err == nil {
+		t.Error("expected an error for an ID without a separator")
+	}
+}
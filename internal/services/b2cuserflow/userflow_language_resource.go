@@ -0,0 +1,311 @@
+package b2cuserflow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// pageCollection is the Graph sub-collection that a page's content is uploaded/read through.
+// `overridesPages` holds tenant-authored overrides; `defaultPages` holds the built-in content
+// shipped by Microsoft for the page, which some tenants customize directly instead.
+const (
+	pageCollectionDefault   = "defaultPages"
+	pageCollectionOverrides = "overridesPages"
+)
+
+func b2cUserflowLanguageResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: b2cUserflowLanguageResourceCreate,
+		ReadContext:   b2cUserflowLanguageResourceRead,
+		UpdateContext: b2cUserflowLanguageResourceUpdate,
+		DeleteContext: b2cUserflowLanguageResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, _, err := parseUserFlowLanguageId(id); err != nil {
+				return err
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"user_flow_id": {
+				Description:      "The ID of the B2C user flow",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"language_tag": {
+				Description:      "The RFC 5646 language tag this resource manages",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+			"is_enabled": {
+				Description: "Whether this language is enabled on the user flow",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"page": {
+				Description: "A page whose content should be customized for this language",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"page_id": {
+							Description:      "The identifier of the page, e.g. `unified_sign_in` or `unified_sign_up`",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+						"content": {
+							Description:      "The HTML content for this page, or the path to a local HTML file",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+							DiffSuppressFunc: tf.WhitespaceInsensitiveContentDiffSuppress,
+						},
+						"page_type": {
+							Description: "Whether this content replaces the built-in `default` page content or sets an `override` on top of it",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "override",
+							ValidateFunc: validation.StringInSlice([]string{
+								"default",
+								"override",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func b2cUserflowLanguageResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId := d.Get("user_flow_id").(string)
+	languageTag := d.Get("language_tag").(string)
+
+	if err := setUserFlowLanguageEnabled(ctx, client, userFlowId, languageTag, d.Get("is_enabled").(bool)); err != nil {
+		return tf.ErrorDiagF(err, "Enabling language %q on user flow %q", languageTag, userFlowId)
+	}
+
+	if err := uploadUserFlowLanguagePages(ctx, client, userFlowId, languageTag, d.Get("page").([]interface{})); err != nil {
+		return tf.ErrorDiagF(err, "Uploading page content for language %q on user flow %q", languageTag, userFlowId)
+	}
+
+	d.SetId(fmt.Sprintf("%s/languages/%s", userFlowId, languageTag))
+	return b2cUserflowLanguageResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowLanguageResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, languageTag, err := parseUserFlowLanguageId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user flow language ID %q", d.Id())
+	}
+
+	if d.HasChange("is_enabled") {
+		if err := setUserFlowLanguageEnabled(ctx, client, userFlowId, languageTag, d.Get("is_enabled").(bool)); err != nil {
+			return tf.ErrorDiagF(err, "Updating language %q on user flow %q", languageTag, userFlowId)
+		}
+	}
+
+	if d.HasChange("page") {
+		if err := uploadUserFlowLanguagePages(ctx, client, userFlowId, languageTag, d.Get("page").([]interface{})); err != nil {
+			return tf.ErrorDiagF(err, "Uploading page content for language %q on user flow %q", languageTag, userFlowId)
+		}
+	}
+
+	return b2cUserflowLanguageResourceRead(ctx, d, meta)
+}
+
+func b2cUserflowLanguageResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, languageTag, err := parseUserFlowLanguageId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user flow language ID %q", d.Id())
+	}
+
+	languages, status, err := listUserFlowLanguages(ctx, client, userFlowId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] User flow %q was not found - removing language %q from state!", userFlowId, languageTag)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Listing languages for user flow %q", userFlowId)
+	}
+
+	var found bool
+	for _, l := range languages {
+		if l.ID == languageTag {
+			found = true
+			tf.Set(d, "user_flow_id", userFlowId)
+			tf.Set(d, "language_tag", l.ID)
+			tf.Set(d, "is_enabled", l.IsEnabled)
+			break
+		}
+	}
+	if !found {
+		log.Printf("[DEBUG] Language %q is no longer configured on user flow %q - removing from state!", languageTag, userFlowId)
+		d.SetId("")
+		return nil
+	}
+
+	configuredPages := d.Get("page").([]interface{})
+	pages := make([]map[string]interface{}, 0, len(configuredPages))
+	for _, p := range configuredPages {
+		page := p.(map[string]interface{})
+		pageId := page["page_id"].(string)
+
+		remoteContent, status, err := getUserFlowLanguagePageContent(ctx, client, userFlowId, languageTag, pageId, page["page_type"])
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return tf.ErrorDiagF(err, "Retrieving page %q content for language %q on user flow %q", pageId, languageTag, userFlowId)
+		}
+
+		configuredContent, err := tf.ReadFileOrString(page["content"].(string))
+		if err != nil {
+			return tf.ErrorDiagF(err, "Reading configured content for page %q", pageId)
+		}
+
+		if hashContent(tf.NormalizeWhitespace(remoteContent)) != hashContent(tf.NormalizeWhitespace(configuredContent)) {
+			// Drift detected: fall back to the remote content so Terraform reports the difference.
+			pages = append(pages, map[string]interface{}{
+				"page_id":   pageId,
+				"content":   remoteContent,
+				"page_type": page["page_type"],
+			})
+			continue
+		}
+
+		pages = append(pages, page)
+	}
+	tf.Set(d, "page", pages)
+
+	return nil
+}
+
+func b2cUserflowLanguageResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).B2CUserFlow.UserFlowClient
+
+	userFlowId, languageTag, err := parseUserFlowLanguageId(d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing user flow language ID %q", d.Id())
+	}
+
+	if err := setUserFlowLanguageEnabled(ctx, client, userFlowId, languageTag, false); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Disabling language %q on user flow %q", languageTag, userFlowId)
+	}
+
+	return nil
+}
+
+func parseUserFlowLanguageId(id string) (userFlowId, languageTag string, err error) {
+	const sep = "/languages/"
+	for i := 0; i+len(sep) <= len(id); i++ {
+		if id[i:i+len(sep)] == sep {
+			return id[:i], id[i+len(sep):], nil
+		}
+	}
+	return "", "", fmt.Errorf("expected ID in the format {userFlowId}/languages/{languageTag}, got %q", id)
+}
+
+func setUserFlowLanguageEnabled(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId, languageTag string, enabled bool) error {
+	body, err := json.Marshal(struct {
+		IsEnabled bool `json:"isEnabled"`
+	}{IsEnabled: enabled})
+	if err != nil {
+		return fmt.Errorf("marshalling language state: %+v", err)
+	}
+
+	_, _, err = client.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		ContentType: "application/json",
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s", userFlowId, languageTag),
+		},
+		Body: body,
+	})
+	return err
+}
+
+func uploadUserFlowLanguagePages(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId, languageTag string, pages []interface{}) error {
+	for _, p := range pages {
+		page := p.(map[string]interface{})
+		pageId := page["page_id"].(string)
+		content, err := tf.ReadFileOrString(page["content"].(string))
+		if err != nil {
+			return fmt.Errorf("reading content for page %q: %+v", pageId, err)
+		}
+
+		if _, _, err := client.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+			ContentType: "text/html",
+			Uri: msgraph.Uri{
+				Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s/%s/%s/$value", userFlowId, languageTag, pageCollection(page["page_type"]), pageId),
+			},
+			Body: []byte(content),
+		}); err != nil {
+			return fmt.Errorf("uploading content for page %q: %+v", pageId, err)
+		}
+	}
+	return nil
+}
+
+func getUserFlowLanguagePageContent(ctx context.Context, client *msgraph.B2CUserFlowClient, userFlowId, languageTag, pageId string, pageType interface{}) (string, int, error) {
+	resp, status, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		Uri: msgraph.Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s/%s/%s/$value", userFlowId, languageTag, pageCollection(pageType), pageId),
+		},
+		OData: odata.Query{},
+	})
+	if err != nil {
+		return "", status, err
+	}
+	return resp.String(), status, nil
+}
+
+// pageCollection maps the `page_type` attribute to the Graph sub-collection it uploads/reads
+// through. An empty or unrecognized value defaults to overridesPages, matching the schema default.
+func pageCollection(pageType interface{}) string {
+	if pageType == "default" {
+		return pageCollectionDefault
+	}
+	return pageCollectionOverrides
+}
+
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,20 @@
+package b2cuserflow
+
+import "testing"
+
+func TestPageCollection(t *testing.T) {
+	cases := []struct {
+		pageType interface{}
+		want     string
+	}{
+		{"default", pageCollectionDefault},
+		{"override", pageCollectionOverrides},
+		{nil, pageCollectionOverrides},
+		{"", pageCollectionOverrides},
+	}
+	for _, c := range cases {
+		if got := pageCollection(c.pageType); got != c.want {
+			t.Errorf("pageCollection(%v): expected %q, got %q", c.pageType, c.want, got)
+		}
+	}
+}
@@ -16,9 +16,7 @@ import (
 	"github.com/manicminer/hamilton/odata"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
-	"github.com/hashicorp/terraform-provider-azuread/internal/helpers"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
-	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
@@ -111,7 +109,24 @@ func b2cuserflowResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagF(errors.New("API returned nil object ID"), "Bad API Response")
 	}
 
-	d.SetId(fmt.Sprintf("B2C_1_%s", name))
+	objectId := fmt.Sprintf("B2C_1_%s", name)
+
+	// Wait for the userflow to become GET-able, eliminating the race between Create returning
+	// and the immediate Read that Terraform performs afterwards.
+	if err := waitForUserFlowState(ctx, func(ctx context.Context) (bool, error) {
+		client.BaseClient.DisableRetries = true
+		if _, status, err := client.Get(ctx, objectId, odata.Query{}); err != nil {
+			if status == http.StatusNotFound || status == http.StatusTooManyRequests {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of userflow %q", objectId)
+	}
+
+	d.SetId(objectId)
 	return b2cuserflowResourceRead(ctx, d, meta)
 }
 
@@ -141,6 +156,28 @@ func b2cuserflowResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not update userflow with ID: %q", d.Id())
 	}
+
+	// Wait for the update to be reflected before Read runs, for the same reason Create does.
+	if err := waitForUserFlowState(ctx, func(ctx context.Context) (bool, error) {
+		client.BaseClient.DisableRetries = true
+		got, status, err := client.Get(ctx, objectId, odata.Query{})
+		if err != nil {
+			if status == http.StatusTooManyRequests {
+				return false, nil
+			}
+			return false, err
+		}
+		if got.DefaultLanguageTag == nil || *got.DefaultLanguageTag != defaultTag {
+			return false, nil
+		}
+		if got.IsLanguageCustomizationEnabled == nil || *got.IsLanguageCustomizationEnabled != isLanguageCustomizationEnabled {
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for update of userflow %q", objectId)
+	}
+
 	return b2cuserflowResourceRead(ctx, d, meta)
 }
 
@@ -182,16 +219,20 @@ func b2cuserflowResourceDelete(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagPathF(err, "id", "Deleting userflow with object ID %q, got status %d", objectId, status)
 	}
 
-	// Wait for userflow object to be deleted
-	if err := helpers.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+	// Wait for userflow object to be deleted, backing off exponentially (with jitter) instead of
+	// hammering Graph, and retrying 429 responses the same way as any other not-yet-done state.
+	if err := waitForUserFlowState(ctx, func(ctx context.Context) (bool, error) {
 		client.BaseClient.DisableRetries = true
 		if _, status, err := client.Get(ctx, objectId, odata.Query{}); err != nil {
 			if status == http.StatusNotFound {
-				return utils.Bool(false), nil
+				return true, nil
+			}
+			if status == http.StatusTooManyRequests {
+				return false, nil
 			}
-			return nil, err
+			return false, err
 		}
-		return utils.Bool(true), nil
+		return false, nil
 	}); err != nil {
 		return tf.ErrorDiagF(err, "Waiting for deletion of userflow with object ID %q", objectId)
 	}
@@ -0,0 +1,50 @@
+package b2cuserflow
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// waiterBaseDelay and waiterMaxDelay are vars rather than consts so tests can shrink them and
+// exercise the backoff schedule without real wall-clock waits.
+var (
+	waiterBaseDelay = 2 * time.Second
+	waiterMaxDelay  = 30 * time.Second
+)
+
+// waitForUserFlowState polls refresh with exponential backoff and jitter until it reports the
+// desired state, the context is cancelled, or no further progress can be made. refresh should
+// treat a 429 the same as any other "not ready yet" response (return done=false, err=nil) so
+// that throttling is retried instead of surfaced as a failure. There's no Retry-After-aware
+// fast path here: the hamilton client used throughout this package (see unmarshalGraphResponse
+// and its callers) drains the response into a *bytes.Buffer and never exposes headers, so this
+// package has no way to read Graph's specific guidance; every retry, 429 or otherwise, simply
+// follows this exponential schedule.
+func waitForUserFlowState(ctx context.Context, refresh func(ctx context.Context) (done bool, err error)) error {
+	delay := waiterBaseDelay
+
+	for {
+		done, err := refresh(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		wait := delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while waiting: %+v", ctx.Err())
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > waiterMaxDelay {
+			delay = waiterMaxDelay
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package b2cuserflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForUserFlowStateReturnsOnceDone(t *testing.T) {
+	oldBase, oldMax := waiterBaseDelay, waiterMaxDelay
+	waiterBaseDelay, waiterMaxDelay = 5*time.Millisecond, 200*time.Millisecond
+	defer func() { waiterBaseDelay, waiterMaxDelay = oldBase, oldMax }()
+
+	calls := 0
+	err := waitForUserFlowState(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 2, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestWaitForUserFlowStateSurfacesRefreshError(t *testing.T) {
+	wantErr := errors.New("boom")
+	err := waitForUserFlowState(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+}
+
+func TestWaitForUserFlowStateBacksOffBetweenRetries(t *testing.T) {
+	oldBase, oldMax := waiterBaseDelay, waiterMaxDelay
+	waiterBaseDelay, waiterMaxDelay = 5*time.Millisecond, 200*time.Millisecond
+	defer func() { waiterBaseDelay, waiterMaxDelay = oldBase, oldMax }()
+
+	// refresh reporting a 429-like "not ready yet" is given no special treatment: it grows the
+	// delay on the same exponential schedule as any other retry, never a fixed delay.
+	var gaps []time.Duration
+	last := time.Now()
+	calls := 0
+	err := waitForUserFlowState(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		now := time.Now()
+		gaps = append(gaps, now.Sub(last))
+		last = now
+		return calls == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(gaps) != 3 {
+		t.Fatalf("expected 3 calls, got %d", len(gaps))
+	}
+	if gaps[2] <= gaps[1] {
+		t.Errorf("expected the delay before the third call (%s) to exceed the delay before the second (%s)", gaps[2], gaps[1])
+	}
+}
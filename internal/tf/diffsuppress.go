@@ -0,0 +1,30 @@
+package tf
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NormalizeWhitespace collapses all runs of whitespace (including newlines) down to a single
+// space, so that two documents can be compared for meaningful differences while ignoring
+// incidental reformatting such as a round-trip through an API.
+func NormalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// WhitespaceInsensitiveContentDiffSuppress is a DiffSuppressFunc for content attributes that
+// accept either inline text or a path to a local file (see ReadFileOrString), suppressing diffs
+// that are whitespace-only. This is the common case for resources that upload a document (XML,
+// HTML, ...) and re-read it afterwards, where the API may reformat the content without changing
+// it meaningfully. `new` holds whatever the user configured, which may need resolving via
+// ReadFileOrString; `old` is assumed to already be the resolved content persisted to state by
+// Read, so no resolution is needed there. If the configured value can't be read, the raw strings
+// are compared instead, so the diff still surfaces rather than being silently suppressed.
+func WhitespaceInsensitiveContentDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	content, err := ReadFileOrString(new)
+	if err != nil {
+		return NormalizeWhitespace(old) == NormalizeWhitespace(new)
+	}
+	return NormalizeWhitespace(old) == NormalizeWhitespace(content)
+}
@@ -0,0 +1,14 @@
+package tf
+
+import "testing"
+
+func TestWhitespaceInsensitiveContentDiffSuppress(t *testing.T) {
+	old := "<html>\n  <body>\n    Hello\n  </body>\n</html>\n"
+	new := "<html><body> Hello </body></html>"
+	if !WhitespaceInsensitiveContentDiffSuppress("content", old, new, nil) {
+		t.Error("expected reformatted content with the same meaning to suppress the diff")
+	}
+	if WhitespaceInsensitiveContentDiffSuppress("content", old, "<html><body>Goodbye</body></html>", nil) {
+		t.Error("expected genuinely different content to not suppress the diff")
+	}
+}